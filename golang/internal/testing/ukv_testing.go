@@ -1,15 +1,36 @@
 package ukv_testing
 
-import "testing"
+import (
+	"testing"
+
+	u "github.com/unum-cloud/UKV/golang/internal"
+)
 
 type dataBase interface {
 	ReConnect(string) error
+	ReConnectWithOptions(string, u.Options) error
 	Close()
 	Set(uint64, []byte) error
 	SetBatch([]uint64, [][]byte) error
 	Delete(uint64) error
 	Get(uint64) ([]byte, error)
 	Contains(uint64) (bool, error)
+	OpenCollection(string) (*u.Collection, error)
+	DropCollection(string) error
+	NewIterator(u.IterOptions) *u.Iterator
+	Range(uint64, uint64, func(uint64, []byte) bool) error
+	BeginTxn(u.TxnOptions) (*u.Txn, error)
+	Snapshot() (*u.Snapshot, error)
+	SetMergeOperator(u.MergeFn) error
+	Merge(uint64, []byte) error
+	MergeBatch([]uint64, [][]byte) error
+	NewWriteBatch() *u.WriteBatch
+	NewArena() *u.Arena
+	SetWithArena(*u.Arena, uint64, []byte) error
+	GetWithArena(*u.Arena, uint64) ([]byte, error)
+	CreateCheckpoint(string) error
+	OpenBackupEngine(string) (*u.BackupEngine, error)
+	Self() *u.DataBase
 }
 
 func DataBaseSimpleTest(db dataBase, t *testing.T) {
@@ -31,6 +52,397 @@ func DataBaseSimpleTest(db dataBase, t *testing.T) {
 	}
 }
 
+func DataBaseRangeScanTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	keys := []uint64{4, 6, 8}
+	values := [][]byte{
+		[]byte("Hello"),
+		[]byte("This"),
+		[]byte("Day")}
+
+	if err := db.SetBatch(keys, values); err != nil {
+		t.Fatalf("Couldn't set value: %s", err)
+	}
+
+	it := db.NewIterator(u.IterOptions{})
+	defer it.Close()
+
+	var seen []uint64
+	for it.Valid() {
+		seen = append(seen, it.Key())
+		it.Next()
+	}
+	if len(seen) != len(keys) {
+		t.Fatalf("Expected %d keys, got %d", len(keys), len(seen))
+	}
+	if it.Err() != nil {
+		t.Fatalf("Expected a clean end of scan, got: %s", it.Err())
+	}
+
+	var ranged []uint64
+	if err := db.Range(0, 100, func(key uint64, value []byte) bool {
+		ranged = append(ranged, key)
+		return true
+	}); err != nil {
+		t.Fatalf("Couldn't range over keys: %s", err)
+	}
+	if len(ranged) != len(keys) {
+		t.Fatalf("Expected %d keys from Range, got %d", len(keys), len(ranged))
+	}
+}
+
+func DataBaseCollectionTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	collection, err := db.OpenCollection("docs")
+	if err != nil {
+		t.Fatalf("Couldn't open collection: %s", err)
+	}
+
+	if err := collection.Set(42, []byte{1, 1, 1}); err != nil {
+		t.Fatalf("Couldn't set value in collection: %s", err)
+	}
+
+	if found, err := collection.Contains(42); err != nil {
+		t.Fatalf("Couldn't check value existance in collection: %s", err)
+	} else if !found {
+		t.Fatalf("Value should be present in collection")
+	}
+
+	if found, err := db.Contains(42); err != nil {
+		t.Fatalf("Couldn't check value existance: %s", err)
+	} else if found {
+		t.Fatalf("Collections must not leak into the default keyspace")
+	}
+
+	if err := db.DropCollection("docs"); err != nil {
+		t.Fatalf("Couldn't drop collection: %s", err)
+	}
+}
+
+func DataBaseTxnSnapshotIsolationTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	if err := db.Set(7, []byte("before")); err != nil {
+		t.Fatalf("Couldn't set value: %s", err)
+	}
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("Couldn't take snapshot: %s", err)
+	}
+	defer snapshot.Close()
+
+	if err := db.Set(7, []byte("after")); err != nil {
+		t.Fatalf("Couldn't overwrite value: %s", err)
+	}
+
+	value, err := snapshot.Get(7)
+	if err != nil {
+		t.Fatalf("Couldn't read from snapshot: %s", err)
+	}
+	if string(value) != "before" {
+		t.Fatalf("Snapshot should not observe later writes: got %q", value)
+	}
+}
+
+func DataBaseTxnConflictTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	if err := db.Set(9, []byte("initial")); err != nil {
+		t.Fatalf("Couldn't set value: %s", err)
+	}
+
+	first, err := db.BeginTxn(u.TxnOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't begin first txn: %s", err)
+	}
+	second, err := db.BeginTxn(u.TxnOptions{})
+	if err != nil {
+		t.Fatalf("Couldn't begin second txn: %s", err)
+	}
+
+	if err := first.Set(9, []byte("from-first")); err != nil {
+		t.Fatalf("Couldn't write through first txn: %s", err)
+	}
+	if err := second.Set(9, []byte("from-second")); err != nil {
+		t.Fatalf("Couldn't write through second txn: %s", err)
+	}
+
+	if err := first.Commit(); err != nil {
+		t.Fatalf("First txn should commit cleanly: %s", err)
+	}
+	if err := second.Commit(); err != u.ErrTxnConflict {
+		t.Fatalf("Second txn should fail with a conflict, got: %v", err)
+	}
+}
+
+func DataBaseMergeTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	counter := func(key uint64, existing []byte, operands [][]byte) ([]byte, error) {
+		total := 0
+		if len(existing) > 0 {
+			total = int(existing[0])
+		}
+		for _, operand := range operands {
+			total += int(operand[0])
+		}
+		return []byte{byte(total)}, nil
+	}
+	if err := db.SetMergeOperator(counter); err != nil {
+		t.Fatalf("Couldn't register merge operator: %s", err)
+	}
+
+	if err := db.Merge(11, []byte{1}); err != nil {
+		t.Fatalf("Couldn't merge value: %s", err)
+	}
+	if err := db.Merge(11, []byte{2}); err != nil {
+		t.Fatalf("Couldn't merge value: %s", err)
+	}
+
+	value, err := db.Get(11)
+	if err != nil {
+		t.Fatalf("Couldn't get merged value: %s", err)
+	}
+	if len(value) != 1 || value[0] != 3 {
+		t.Fatalf("Expected merged counter 3, got %v", value)
+	}
+
+	presence := func(key uint64, existing []byte, operands [][]byte) ([]byte, error) {
+		return []byte{1}, nil
+	}
+	if err := db.SetMergeOperator(presence); err != nil {
+		t.Fatalf("Couldn't register merge operator: %s", err)
+	}
+	if err := db.Merge(12, nil); err != nil {
+		t.Fatalf("Couldn't merge with an empty operand: %s", err)
+	}
+	presenceValue, err := db.Get(12)
+	if err != nil {
+		t.Fatalf("Couldn't get value merged with an empty operand: %s", err)
+	}
+	if len(presenceValue) != 1 || presenceValue[0] != 1 {
+		t.Fatalf("Expected presence marker, got %v", presenceValue)
+	}
+}
+
+// DataBaseMergeAtOpenTest covers registering the merge operator through
+// ReConnectWithOptions instead of a separate SetMergeOperator call.
+func DataBaseMergeAtOpenTest(db dataBase, t *testing.T) {
+	counter := func(key uint64, existing []byte, operands [][]byte) ([]byte, error) {
+		total := 0
+		if len(existing) > 0 {
+			total = int(existing[0])
+		}
+		for _, operand := range operands {
+			total += int(operand[0])
+		}
+		return []byte{byte(total)}, nil
+	}
+
+	if err := db.ReConnectWithOptions("", u.Options{MergeOperator: counter}); err != nil {
+		t.Fatalf("Couldn't open db with merge operator: %s", err)
+	}
+	defer db.Close()
+
+	if err := db.Merge(13, []byte{1}); err != nil {
+		t.Fatalf("Couldn't merge value: %s", err)
+	}
+	if err := db.Merge(13, []byte{2}); err != nil {
+		t.Fatalf("Couldn't merge value: %s", err)
+	}
+
+	value, err := db.Get(13)
+	if err != nil {
+		t.Fatalf("Couldn't get merged value: %s", err)
+	}
+	if len(value) != 1 || value[0] != 3 {
+		t.Fatalf("Expected merged counter 3, got %v", value)
+	}
+}
+
+func DataBaseWriteBatchTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	keys := []uint64{21, 23, 25}
+	values := [][]byte{
+		[]byte("Some"),
+		[]byte("Batched"),
+		[]byte("Values")}
+
+	batch := db.NewWriteBatch()
+	for i, key := range keys {
+		batch.Set(key, values[i])
+	}
+	if err := batch.Flush(u.WriteOptions{}); err != nil {
+		t.Fatalf("Couldn't flush write batch: %s", err)
+	}
+
+	for i, key := range keys {
+		val, err := db.Get(key)
+		if err != nil {
+			t.Fatalf("Couldn't get value: %s", err)
+		}
+		if string(val) != string(values[i]) {
+			t.Fatalf("Wrong Value: Expected: %s, Got: %s", string(values[i]), string(val))
+		}
+	}
+
+	batch.Reset()
+	if batch.Len() != 0 {
+		t.Fatalf("Expected batch to be empty after Reset, got %d entries", batch.Len())
+	}
+}
+
+func DataBaseArenaTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	arena := db.NewArena()
+	defer arena.Close()
+
+	if err := db.SetWithArena(arena, 27, []byte("pooled")); err != nil {
+		t.Fatalf("Couldn't set value with arena: %s", err)
+	}
+
+	value, err := db.GetWithArena(arena, 27)
+	if err != nil {
+		t.Fatalf("Couldn't get value with arena: %s", err)
+	}
+	if string(value) != "pooled" {
+		t.Fatalf("Expected %q, got %q", "pooled", value)
+	}
+}
+
+func DataBaseBackupRestoreTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+
+	keys := []uint64{31, 33, 35}
+	values := [][]byte{
+		[]byte("Backed"),
+		[]byte("Up"),
+		[]byte("Values")}
+	if err := db.SetBatch(keys, values); err != nil {
+		t.Fatalf("Couldn't set value: %s", err)
+	}
+
+	backupDir := t.TempDir()
+	engine, err := db.OpenBackupEngine(backupDir)
+	if err != nil {
+		t.Fatalf("Couldn't open backup engine: %s", err)
+	}
+	defer engine.Close()
+
+	if err := engine.CreateBackup(db.Self(), true); err != nil {
+		t.Fatalf("Couldn't create backup: %s", err)
+	}
+
+	infos, err := engine.GetBackupInfo()
+	if err != nil {
+		t.Fatalf("Couldn't get backup info: %s", err)
+	}
+	if len(infos) != 1 {
+		t.Fatalf("Expected 1 backup, got %d", len(infos))
+	}
+
+	db.Close()
+
+	restoreDir := t.TempDir()
+	if err := engine.RestoreFromLatestBackup(restoreDir); err != nil {
+		t.Fatalf("Couldn't restore from backup: %s", err)
+	}
+
+	if err := db.ReConnect(restoreDir); err != nil {
+		t.Fatalf("Couldn't open restored db: %s", err)
+	}
+	defer db.Close()
+
+	for i, key := range keys {
+		val, err := db.Get(key)
+		if err != nil {
+			t.Fatalf("Couldn't get value from restored db: %s", err)
+		}
+		if string(val) != string(values[i]) {
+			t.Fatalf("Wrong Value: Expected: %s, Got: %s", string(values[i]), string(val))
+		}
+	}
+
+	if err := engine.PurgeOldBackups(0); err != nil {
+		t.Fatalf("Couldn't purge old backups: %s", err)
+	}
+}
+
+func DataBaseCheckpointTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+
+	if err := db.Set(37, []byte("checkpointed")); err != nil {
+		t.Fatalf("Couldn't set value: %s", err)
+	}
+
+	checkpointDir := t.TempDir() + "/checkpoint"
+	if err := db.CreateCheckpoint(checkpointDir); err != nil {
+		t.Fatalf("Couldn't create checkpoint: %s", err)
+	}
+	db.Close()
+
+	if err := db.ReConnect(checkpointDir); err != nil {
+		t.Fatalf("Couldn't open checkpoint: %s", err)
+	}
+	defer db.Close()
+
+	value, err := db.Get(37)
+	if err != nil {
+		t.Fatalf("Couldn't get value from checkpoint: %s", err)
+	}
+	if string(value) != "checkpointed" {
+		t.Fatalf("Expected %q, got %q", "checkpointed", value)
+	}
+}
+
+// DataBaseBackupNotSupportedTest covers backends with no native checkpoint
+// or backup engine, such as the in-memory STL backend: both entry points
+// must fail honestly with u.ErrNotSupported instead of silently no-op'ing.
+func DataBaseBackupNotSupportedTest(db dataBase, t *testing.T) {
+	if err := db.ReConnect(""); err != nil {
+		t.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	if err := db.CreateCheckpoint(t.TempDir() + "/checkpoint"); err != u.ErrNotSupported {
+		t.Fatalf("Expected ErrNotSupported from CreateCheckpoint, got: %v", err)
+	}
+
+	if _, err := db.OpenBackupEngine(t.TempDir()); err != u.ErrNotSupported {
+		t.Fatalf("Expected ErrNotSupported from OpenBackupEngine, got: %v", err)
+	}
+}
+
 func DataBaseBatchInsertTest(db dataBase, t *testing.T) {
 	if err := db.ReConnect(""); err != nil {
 		t.Fatalf("Couldn't open db: %s", err)
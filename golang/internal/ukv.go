@@ -6,11 +6,14 @@ package ukv
 #include <stdlib.h>
 
 ukv_val_len_t dereference_index(ukv_val_len_t* lens, ukv_size_t idx) { return lens[idx]; }
+ukv_size_t dereference_size_index(ukv_size_t* counts, ukv_size_t idx) { return counts[idx]; }
 
 typedef void (*open_fn)(ukv_str_view_t, ukv_t*, ukv_error_t*);
 typedef void (*error_free_fn)(ukv_error_t);
 typedef void (*arena_free_fn)(ukv_t const, ukv_arena_t);
 typedef void (*free_fn)(ukv_t);
+typedef void (*collection_open_fn)(ukv_t const, ukv_str_view_t, ukv_options_t const, ukv_collection_t*, ukv_error_t*);
+typedef void (*collection_drop_fn)(ukv_t const, ukv_collection_t, ukv_error_t*);
 typedef void (*read_fn)(ukv_t const, ukv_txn_t const, ukv_size_t const,
     ukv_collection_t const*, ukv_size_t const, ukv_key_t const*,
     ukv_size_t const, ukv_options_t const, ukv_val_len_t**,
@@ -20,6 +23,26 @@ typedef void (*write_fn)(ukv_t const, ukv_txn_t const,ukv_size_t const,
     ukv_size_t const, ukv_val_ptr_t const*, ukv_size_t const,
     ukv_val_len_t const*, ukv_size_t const, ukv_val_len_t const*,
     ukv_size_t const, ukv_options_t const, ukv_arena_t*, ukv_error_t*);
+typedef void (*scan_fn)(ukv_t const, ukv_txn_t const, ukv_size_t const,
+    ukv_collection_t const*, ukv_size_t const, ukv_key_t const*,
+    ukv_size_t const, ukv_size_t const*, ukv_size_t const,
+    ukv_options_t const, ukv_size_t**, ukv_key_t**, ukv_arena_t*, ukv_error_t*);
+typedef void (*txn_begin_fn)(ukv_t const, ukv_size_t const, ukv_options_t const, ukv_txn_t*, ukv_error_t*);
+typedef void (*txn_commit_fn)(ukv_t const, ukv_txn_t const, ukv_options_t const, ukv_error_t*);
+typedef void (*txn_free_fn)(ukv_t const, ukv_txn_t);
+typedef void (*merge_callback_fn)(ukv_t const, ukv_key_t const,
+    ukv_val_ptr_t, ukv_val_len_t,
+    ukv_val_ptr_t const*, ukv_val_len_t const*, ukv_size_t const,
+    ukv_val_ptr_t*, ukv_val_len_t*, ukv_error_t*);
+typedef void (*merge_operator_set_fn)(ukv_t const, merge_callback_fn, ukv_error_t*);
+typedef void (*checkpoint_create_fn)(ukv_t const, ukv_str_view_t, ukv_error_t*);
+typedef void (*backup_engine_open_fn)(ukv_str_view_t, ukv_backup_t*, ukv_error_t*);
+typedef void (*backup_create_fn)(ukv_backup_t const, ukv_t const, bool const, ukv_error_t*);
+typedef void (*backup_restore_fn)(ukv_backup_t const, ukv_str_view_t, ukv_error_t*);
+typedef void (*backup_purge_old_fn)(ukv_backup_t const, ukv_size_t const, ukv_error_t*);
+typedef void (*backup_get_info_fn)(ukv_backup_t const, ukv_size_t*,
+    ukv_size_t**, ukv_size_t**, ukv_size_t**, ukv_size_t**, ukv_error_t*);
+typedef void (*backup_free_fn)(ukv_backup_t);
 
 
 void u_open(void* fn, ukv_str_view_t c_config, ukv_t* c_db, ukv_error_t* c_error) {
@@ -42,6 +65,16 @@ void u_free(void*fn, ukv_t c_db) {
 	(*func)(c_db);
 }
 
+void u_collection_open(void* fn, ukv_t const c_db, ukv_str_view_t c_name, ukv_options_t const c_options, ukv_collection_t* c_collection, ukv_error_t* c_error) {
+	collection_open_fn func = (collection_open_fn)(fn);
+	(*func)(c_db, c_name, c_options, c_collection, c_error);
+}
+
+void u_collection_drop(void* fn, ukv_t const c_db, ukv_collection_t c_collection, ukv_error_t* c_error) {
+	collection_drop_fn func = (collection_drop_fn)(fn);
+	(*func)(c_db, c_collection, c_error);
+}
+
 void u_read(void* fn, ukv_t const c_db, ukv_txn_t const c_txn, ukv_size_t const c_tasks_count,
 		ukv_collection_t const* c_cols, ukv_size_t const c_cols_stride, ukv_key_t const* c_keys,
 		ukv_size_t const c_keys_stride, ukv_options_t const c_options, ukv_val_len_t** c_found_lengths,
@@ -65,6 +98,75 @@ void u_write(void* fn, ukv_t const c_db, ukv_txn_t const c_txn, ukv_size_t const
 			c_vals_stride, c_offs, c_offs_stride, c_lens, c_lens_stride, c_options, c_arena, c_error);
 }
 
+void u_scan(void* fn, ukv_t const c_db, ukv_txn_t const c_txn, ukv_size_t const c_tasks_count,
+		ukv_collection_t const* c_cols, ukv_size_t const c_cols_stride, ukv_key_t const* c_start_keys,
+		ukv_size_t const c_start_keys_stride, ukv_size_t const* c_scan_limits, ukv_size_t const c_scan_limits_stride,
+		ukv_options_t const c_options, ukv_size_t** c_found_counts, ukv_key_t** c_found_keys,
+		ukv_arena_t* c_arena, ukv_error_t* c_error) {
+
+	scan_fn func = (scan_fn)(fn);
+	(*func)(c_db, c_txn, c_tasks_count, c_cols, c_cols_stride, c_start_keys, c_start_keys_stride,
+			c_scan_limits, c_scan_limits_stride, c_options, c_found_counts, c_found_keys, c_arena, c_error);
+}
+
+void u_txn_begin(void* fn, ukv_t const c_db, ukv_size_t const c_generation, ukv_options_t const c_options,
+		ukv_txn_t* c_txn, ukv_error_t* c_error) {
+	txn_begin_fn func = (txn_begin_fn)(fn);
+	(*func)(c_db, c_generation, c_options, c_txn, c_error);
+}
+
+void u_txn_commit(void* fn, ukv_t const c_db, ukv_txn_t const c_txn, ukv_options_t const c_options, ukv_error_t* c_error) {
+	txn_commit_fn func = (txn_commit_fn)(fn);
+	(*func)(c_db, c_txn, c_options, c_error);
+}
+
+void u_txn_free(void* fn, ukv_t const c_db, ukv_txn_t c_txn) {
+	txn_free_fn func = (txn_free_fn)(fn);
+	(*func)(c_db, c_txn);
+}
+
+void u_merge_operator_set(void* fn, ukv_t const c_db, void* c_callback, ukv_error_t* c_error) {
+	merge_operator_set_fn func = (merge_operator_set_fn)(fn);
+	(*func)(c_db, (merge_callback_fn)(c_callback), c_error);
+}
+
+void u_checkpoint_create(void* fn, ukv_t const c_db, ukv_str_view_t c_dir, ukv_error_t* c_error) {
+	checkpoint_create_fn func = (checkpoint_create_fn)(fn);
+	(*func)(c_db, c_dir, c_error);
+}
+
+void u_backup_engine_open(void* fn, ukv_str_view_t c_dir, ukv_backup_t* c_backup, ukv_error_t* c_error) {
+	backup_engine_open_fn func = (backup_engine_open_fn)(fn);
+	(*func)(c_dir, c_backup, c_error);
+}
+
+void u_backup_create(void* fn, ukv_backup_t const c_backup, ukv_t const c_db, bool const c_flush, ukv_error_t* c_error) {
+	backup_create_fn func = (backup_create_fn)(fn);
+	(*func)(c_backup, c_db, c_flush, c_error);
+}
+
+void u_backup_restore(void* fn, ukv_backup_t const c_backup, ukv_str_view_t c_dir, ukv_error_t* c_error) {
+	backup_restore_fn func = (backup_restore_fn)(fn);
+	(*func)(c_backup, c_dir, c_error);
+}
+
+void u_backup_purge_old(void* fn, ukv_backup_t const c_backup, ukv_size_t const c_keep, ukv_error_t* c_error) {
+	backup_purge_old_fn func = (backup_purge_old_fn)(fn);
+	(*func)(c_backup, c_keep, c_error);
+}
+
+void u_backup_get_info(void* fn, ukv_backup_t const c_backup, ukv_size_t* c_count,
+		ukv_size_t** c_ids, ukv_size_t** c_timestamps, ukv_size_t** c_sizes_bytes,
+		ukv_size_t** c_num_files, ukv_error_t* c_error) {
+	backup_get_info_fn func = (backup_get_info_fn)(fn);
+	(*func)(c_backup, c_count, c_ids, c_timestamps, c_sizes_bytes, c_num_files, c_error);
+}
+
+void u_backup_free(void* fn, ukv_backup_t c_backup) {
+	backup_free_fn func = (backup_free_fn)(fn);
+	(*func)(c_backup);
+}
+
 bool is_null(ukv_val_ptr_t ptr, int len) {
 	return *(*(char**)ptr + len) == 0;
 }
@@ -75,21 +177,50 @@ bool is_null(ukv_val_ptr_t ptr, int len) {
 import "C"
 import (
 	"errors"
+	"runtime"
+	"sync"
 	"unsafe"
 )
 
+// ErrTxnConflict is returned by Txn.Commit when another transaction wrote to
+// one of the same keys first; the caller should retry with a fresh Txn.
+var ErrTxnConflict = errors.New("ukv: transaction conflict, please retry")
+
+// ErrNotSupported is returned by operations a backend has no native support
+// for, such as checkpoints on the in-memory STL backend.
+var ErrNotSupported = errors.New("ukv: operation not supported by this backend")
+
 type UKV_val_len_t = C.ukv_val_len_t
 
 type BackendInterface struct {
-	UKV_error_free      unsafe.Pointer
-	UKV_arena_free      unsafe.Pointer
-	UKV_open            unsafe.Pointer
-	UKV_free            unsafe.Pointer
-	UKV_read            unsafe.Pointer
-	UKV_write           unsafe.Pointer
-	UKV_val_len_missing UKV_val_len_t
+	UKV_error_free         unsafe.Pointer
+	UKV_arena_free         unsafe.Pointer
+	UKV_open               unsafe.Pointer
+	UKV_free               unsafe.Pointer
+	UKV_read               unsafe.Pointer
+	UKV_write              unsafe.Pointer
+	UKV_scan               unsafe.Pointer
+	UKV_collection_open    unsafe.Pointer
+	UKV_collection_drop    unsafe.Pointer
+	UKV_txn_begin          unsafe.Pointer
+	UKV_txn_commit         unsafe.Pointer
+	UKV_txn_free           unsafe.Pointer
+	UKV_merge              unsafe.Pointer
+	UKV_merge_operator_set unsafe.Pointer
+	UKV_checkpoint_create  unsafe.Pointer
+	UKV_backup_engine_open unsafe.Pointer
+	UKV_backup_create      unsafe.Pointer
+	UKV_backup_restore     unsafe.Pointer
+	UKV_backup_purge_old   unsafe.Pointer
+	UKV_backup_get_info    unsafe.Pointer
+	UKV_backup_free        unsafe.Pointer
+	UKV_val_len_missing    UKV_val_len_t
 }
 
+// defaultIterWindow is the number of keys fetched from the underlying engine
+// per round-trip; Next() only crosses into C once the window is exhausted.
+const defaultIterWindow = 256
+
 /**
  * This class is modeled after Redis client and other ORMs:
  * https://github.com/go-redis/redis
@@ -126,14 +257,80 @@ func (db *DataBase) ReConnect(config string) error {
 	return forwardError(db, error_c)
 }
 
+// Self returns db itself. It lets generic helper code that only holds the
+// dataBase interface (promoted from an embedded DataBase) recover the
+// concrete *DataBase that APIs like BackupEngine.CreateBackup expect.
+func (db *DataBase) Self() *DataBase {
+	return db
+}
+
 func (db *DataBase) Close() {
 	if db.raw != nil {
+		mergeOperatorsMu.Lock()
+		delete(mergeOperators, unsafe.Pointer(db.raw))
+		mergeOperatorsMu.Unlock()
+
 		C.u_free(db.Backend.UKV_free, db.raw)
 		db.raw = nil
 	}
 }
 
+// OpenCollection resolves (and implicitly creates) a named keyspace that
+// shares the underlying storage and WAL with the default collection,
+// mirroring the RocksDB column-family model.
+func (db *DataBase) OpenCollection(name string) (*Collection, error) {
+	return db.openCollection(name, C.ukv_options_t(C.ukv_options_default_k))
+}
+
+func (db *DataBase) openCollection(name string, options_c C.ukv_options_t) (*Collection, error) {
+
+	error_c := C.ukv_error_t(nil)
+	name_c := C.CString(name)
+	defer C.free(unsafe.Pointer(name_c))
+	collection_c := C.ukv_collection_t(nil)
+
+	C.u_collection_open(db.Backend.UKV_collection_open, db.raw, name_c, options_c, &collection_c, &error_c)
+	if error_go := forwardError(db, error_c); error_go != nil {
+		return nil, error_go
+	}
+
+	return &Collection{db: db, raw: collection_c}, nil
+}
+
+// DropCollection removes a named keyspace and everything stored in it. It
+// resolves name with ukv_option_collection_dont_create_k, so, unlike
+// OpenCollection, dropping a name that was never created errors instead of
+// creating and immediately dropping it.
+func (db *DataBase) DropCollection(name string) error {
+
+	collection, error_go := db.openCollection(name, C.ukv_options_t(C.ukv_option_collection_dont_create_k))
+	if error_go != nil {
+		return error_go
+	}
+	return collection.Drop()
+}
+
 func (db *DataBase) Set(key uint64, value []byte) error {
+	return db.set(nil, (*C.ukv_collection_t)(nil), key, value)
+}
+
+func (db *DataBase) SetBatch(keys []uint64, values [][]byte) error {
+	return db.setBatch(nil, (*C.ukv_collection_t)(nil), keys, values)
+}
+
+func (db *DataBase) Delete(key uint64) error {
+	return db.delete(nil, (*C.ukv_collection_t)(nil), key)
+}
+
+func (db *DataBase) Get(key uint64) ([]byte, error) {
+	return db.get(nil, (*C.ukv_collection_t)(nil), key)
+}
+
+func (db *DataBase) Contains(key uint64) (bool, error) {
+	return db.contains(nil, (*C.ukv_collection_t)(nil), key)
+}
+
+func (db *DataBase) set(txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, key uint64, value []byte) error {
 
 	// Passing values without copies seems essentially impossible
 	// and causes: "cgo argument has Go pointer to Go pointer"
@@ -141,7 +338,6 @@ func (db *DataBase) Set(key uint64, value []byte) error {
 	// https://stackoverflow.com/a/64867672
 	error_c := C.ukv_error_t(nil)
 	key_c := C.ukv_key_t(key)
-	collection_c := (*C.ukv_collection_t)(nil)
 	options_c := C.ukv_options_t(C.ukv_options_default_k)
 	val_ptr := C.ukv_val_ptr_t(unsafe.Pointer(&value[0]))
 	value_ptr_c := C.ukv_val_ptr_t(unsafe.Pointer(&val_ptr))
@@ -151,7 +347,7 @@ func (db *DataBase) Set(key uint64, value []byte) error {
 	defer freeArena(db, arena_c)
 
 	C.u_write(db.Backend.UKV_write,
-		db.raw, nil, 1,
+		db.raw, txn_c, 1,
 		collection_c, 0,
 		&key_c, 0,
 		value_ptr_c, 0,
@@ -161,11 +357,10 @@ func (db *DataBase) Set(key uint64, value []byte) error {
 	return forwardError(db, error_c)
 }
 
-func (db *DataBase) SetBatch(keys []uint64, values [][]byte) error {
+func (db *DataBase) setBatch(txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, keys []uint64, values [][]byte) error {
 
 	error_c := C.ukv_error_t(nil)
 	keys_c := (*C.ukv_key_t)(unsafe.Pointer(&keys[0]))
-	collection_c := (*C.ukv_collection_t)(nil)
 	options_c := C.ukv_options_t(C.ukv_options_default_k)
 	value_ptr_c := C.ukv_val_ptr_t(unsafe.Pointer(&values[0]))
 	task_count_c := C.size_t(len(values))
@@ -188,7 +383,7 @@ func (db *DataBase) SetBatch(keys []uint64, values [][]byte) error {
 	defer freeArena(db, arena_c)
 
 	C.u_write(db.Backend.UKV_write,
-		db.raw, nil, task_count_c,
+		db.raw, txn_c, task_count_c,
 		collection_c, 0,
 		keys_c, C.size_of_key,
 		value_ptr_c, 0,
@@ -198,7 +393,7 @@ func (db *DataBase) SetBatch(keys []uint64, values [][]byte) error {
 	return forwardError(db, error_c)
 }
 
-func (db *DataBase) Delete(key uint64) error {
+func (db *DataBase) delete(txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, key uint64) error {
 
 	// Passing values without copies seems essentially impossible
 	// and causes: "cgo argument has Go pointer to Go pointer"
@@ -206,7 +401,6 @@ func (db *DataBase) Delete(key uint64) error {
 	// https://stackoverflow.com/a/64867672
 	error_c := C.ukv_error_t(nil)
 	key_c := C.ukv_key_t(key)
-	collection_c := (*C.ukv_collection_t)(nil)
 	options_c := C.ukv_options_t(C.ukv_options_default_k)
 	value_ptr_c := C.ukv_val_ptr_t(nil)
 	value_length_c := C.ukv_val_len_t(0)
@@ -215,7 +409,7 @@ func (db *DataBase) Delete(key uint64) error {
 	defer freeArena(db, arena_c)
 
 	C.u_write(db.Backend.UKV_write,
-		db.raw, nil, 1,
+		db.raw, txn_c, 1,
 		collection_c, 0,
 		&key_c, 0,
 		value_ptr_c, 0,
@@ -225,13 +419,12 @@ func (db *DataBase) Delete(key uint64) error {
 	return forwardError(db, error_c)
 }
 
-func (db *DataBase) Get(key uint64) ([]byte, error) {
+func (db *DataBase) get(txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, key uint64) ([]byte, error) {
 
 	// Even though we can't properly write without a single copy
 	// from Go layer, but we can read entries from C-allocated buffers.
 	error_c := C.ukv_error_t(nil)
 	key_c := C.ukv_key_t(key)
-	collection_c := (*C.ukv_collection_t)(nil)
 	options_c := C.ukv_options_t(C.ukv_options_default_k)
 	pulled_values_lengths_c := (*C.ukv_val_len_t)(nil)
 	pulled_values_c := (C.ukv_val_ptr_t)(nil)
@@ -239,7 +432,7 @@ func (db *DataBase) Get(key uint64) ([]byte, error) {
 	defer freeArena(db, arena_c)
 
 	C.u_read(db.Backend.UKV_read,
-		db.raw, nil, 1,
+		db.raw, txn_c, 1,
 		collection_c, 0,
 		&key_c, 0,
 		options_c,
@@ -264,13 +457,12 @@ func (db *DataBase) Get(key uint64) ([]byte, error) {
 	}
 }
 
-func (db *DataBase) Contains(key uint64) (bool, error) {
+func (db *DataBase) contains(txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, key uint64) (bool, error) {
 
 	// Even though we can't properly write without a single copy
 	// from Go layer, but we can read entries from C-allocated buffers.
 	error_c := C.ukv_error_t(nil)
 	key_c := C.ukv_key_t(key)
-	collection_c := (*C.ukv_collection_t)(nil)
 	options_c := C.ukv_options_t(C.ukv_option_read_lengths_k)
 	pulled_values_lengths_c := (*C.ukv_val_len_t)(nil)
 	pulled_values_c := (C.ukv_val_ptr_t)(nil)
@@ -278,7 +470,7 @@ func (db *DataBase) Contains(key uint64) (bool, error) {
 	defer freeArena(db, arena_c)
 
 	C.u_read(db.Backend.UKV_read,
-		db.raw, nil, 1,
+		db.raw, txn_c, 1,
 		collection_c, 0,
 		&key_c, 0,
 		options_c,
@@ -294,3 +486,867 @@ func (db *DataBase) Contains(key uint64) (bool, error) {
 
 	return C.dereference_index(pulled_values_lengths_c, 0) != db.Backend.UKV_val_len_missing, nil
 }
+
+// Collection is a logical keyspace within a DataBase, analogous to a RocksDB
+// column family: it has its own keys, but shares the storage engine and WAL
+// with the default collection and every other collection of the same DataBase.
+type Collection struct {
+	db  *DataBase
+	raw C.ukv_collection_t
+}
+
+// Drop removes the collection and everything stored in it.
+func (collection *Collection) Drop() error {
+	error_c := C.ukv_error_t(nil)
+	C.u_collection_drop(collection.db.Backend.UKV_collection_drop, collection.db.raw, collection.raw, &error_c)
+	return forwardError(collection.db, error_c)
+}
+
+func (collection *Collection) Set(key uint64, value []byte) error {
+	return collection.db.set(nil, &collection.raw, key, value)
+}
+
+func (collection *Collection) SetBatch(keys []uint64, values [][]byte) error {
+	return collection.db.setBatch(nil, &collection.raw, keys, values)
+}
+
+func (collection *Collection) Delete(key uint64) error {
+	return collection.db.delete(nil, &collection.raw, key)
+}
+
+func (collection *Collection) Get(key uint64) ([]byte, error) {
+	return collection.db.get(nil, &collection.raw, key)
+}
+
+func (collection *Collection) Contains(key uint64) (bool, error) {
+	return collection.db.contains(nil, &collection.raw, key)
+}
+
+func (collection *Collection) NewIterator(opts IterOptions) *Iterator {
+	return newIterator(collection.db, nil, &collection.raw, opts)
+}
+
+func (collection *Collection) Range(from uint64, to uint64, fn func(key uint64, value []byte) bool) error {
+	return collection.db.rangeOver(&collection.raw, from, to, fn)
+}
+
+// IterOptions configures a range scan started with NewIterator. LowerBound
+// and UpperBound are both optional; when neither is set the iterator walks
+// every key in the collection.
+type IterOptions struct {
+	Limit      uint64
+	LowerBound *uint64
+	UpperBound *uint64
+	KeysOnly   bool
+}
+
+func (opts IterOptions) readOptions() C.ukv_options_t {
+	if opts.KeysOnly {
+		return C.ukv_options_t(C.ukv_option_read_lengths_k)
+	}
+	return C.ukv_options_t(C.ukv_options_default_k)
+}
+
+// Iterator is a forward cursor over a DataBase or Collection keyspace,
+// modeled after LevelDB/RocksDB iterators. It batch-fetches a window of
+// keys/values into the iterator's arena and hands out zero-copy []byte slices
+// that stay valid only until the next Seek/Next call.
+type Iterator struct {
+	db         *DataBase
+	txn_c      C.ukv_txn_t
+	collection *C.ukv_collection_t
+	options    IterOptions
+	arena_c    C.ukv_arena_t
+	keys       []uint64
+	values     [][]byte
+	pos        int
+	cursor     uint64
+	fetched    uint64
+	exhausted  bool
+	err        error
+}
+
+func (db *DataBase) NewIterator(opts IterOptions) *Iterator {
+	return newIterator(db, nil, (*C.ukv_collection_t)(nil), opts)
+}
+
+func newIterator(db *DataBase, txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, opts IterOptions) *Iterator {
+	it := &Iterator{db: db, txn_c: txn_c, collection: collection_c, options: opts}
+	it.SeekToFirst()
+	return it
+}
+
+// SeekToFirst rewinds the iterator back to its lower bound (or zero).
+func (it *Iterator) SeekToFirst() {
+	start := uint64(0)
+	if it.options.LowerBound != nil {
+		start = *it.options.LowerBound
+	}
+	it.Seek(start)
+}
+
+// Seek positions the iterator at the first key greater than or equal to key.
+func (it *Iterator) Seek(key uint64) {
+	it.releaseArena()
+	it.cursor = key
+	it.fetched = 0
+	it.exhausted = false
+	it.fetch()
+}
+
+func (it *Iterator) releaseArena() {
+	if it.arena_c != nil {
+		freeArena(it.db, it.arena_c)
+		it.arena_c = nil
+	}
+	it.keys = nil
+	it.values = nil
+	it.pos = 0
+}
+
+func (it *Iterator) fetch() {
+	if it.exhausted {
+		return
+	}
+
+	window := C.ukv_size_t(defaultIterWindow)
+	if it.options.Limit != 0 {
+		remaining := it.options.Limit - it.fetched
+		if remaining == 0 {
+			it.exhausted = true
+			return
+		}
+		if C.ukv_size_t(remaining) < window {
+			window = C.ukv_size_t(remaining)
+		}
+	}
+
+	error_c := C.ukv_error_t(nil)
+	start_key_c := C.ukv_key_t(it.cursor)
+	scan_limit_c := C.ukv_size_t(window)
+	found_counts_c := (*C.ukv_size_t)(nil)
+	found_keys_c := (*C.ukv_key_t)(nil)
+	arena_c := (C.ukv_arena_t)(nil)
+
+	C.u_scan(it.db.Backend.UKV_scan,
+		it.db.raw, it.txn_c, 1,
+		it.collection, 0,
+		&start_key_c, 0,
+		&scan_limit_c, 0,
+		it.options.readOptions(),
+		&found_counts_c, &found_keys_c,
+		&arena_c, &error_c)
+
+	it.arena_c = arena_c
+	it.err = forwardError(it.db, error_c)
+	if it.err != nil {
+		it.exhausted = true
+		return
+	}
+
+	count := uint64(C.dereference_size_index(found_counts_c, 0))
+	if count < uint64(window) {
+		it.exhausted = true
+	}
+	if count == 0 {
+		it.keys = nil
+		it.values = nil
+		return
+	}
+
+	keys := unsafe.Slice((*C.ukv_key_t)(unsafe.Pointer(found_keys_c)), count)
+	it.keys = make([]uint64, 0, count)
+	for _, key_c := range keys {
+		key := uint64(key_c)
+		if it.options.UpperBound != nil && key >= *it.options.UpperBound {
+			it.exhausted = true
+			break
+		}
+		it.keys = append(it.keys, key)
+	}
+
+	it.fetched += uint64(len(it.keys))
+	if len(it.keys) > 0 {
+		it.cursor = it.keys[len(it.keys)-1] + 1
+	}
+	it.pos = 0
+
+	if !it.options.KeysOnly && len(it.keys) > 0 {
+		it.values, it.err = it.db.getBatch(it.txn_c, it.collection, it.keys, &it.arena_c)
+		if it.err != nil {
+			it.exhausted = true
+			it.keys = nil
+			it.values = nil
+		}
+	} else {
+		it.values = nil
+	}
+}
+
+// Valid reports whether the iterator currently points at a usable entry.
+// Valid returning false can mean either a legitimate end of keyspace or a
+// fetch that failed partway through; call Err to tell the two apart.
+func (it *Iterator) Valid() bool {
+	return it.pos < len(it.keys)
+}
+
+// Err returns the first error the iterator encountered while scanning, or
+// nil if every fetch so far has succeeded, matching the LevelDB/RocksDB
+// iterators this API is modeled after.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Key returns the key the iterator currently points at.
+func (it *Iterator) Key() uint64 {
+	return it.keys[it.pos]
+}
+
+// Value returns the value at the current position. It is backed by the
+// iterator's arena and only valid until the next Seek/Next call.
+func (it *Iterator) Value() []byte {
+	if it.values == nil {
+		return nil
+	}
+	return it.values[it.pos]
+}
+
+// Next advances the iterator, fetching the next window from the engine
+// once the current one is exhausted.
+func (it *Iterator) Next() {
+	it.pos++
+	if it.pos >= len(it.keys) && !it.exhausted {
+		it.releaseArena()
+		it.fetch()
+	}
+}
+
+// Close releases the arena backing the iterator's current window.
+func (it *Iterator) Close() {
+	it.releaseArena()
+}
+
+func (db *DataBase) Range(from uint64, to uint64, fn func(key uint64, value []byte) bool) error {
+	return db.rangeOver((*C.ukv_collection_t)(nil), from, to, fn)
+}
+
+func (db *DataBase) rangeOver(collection_c *C.ukv_collection_t, from uint64, to uint64, fn func(key uint64, value []byte) bool) error {
+	it := newIterator(db, nil, collection_c, IterOptions{LowerBound: &from, UpperBound: &to})
+	defer it.Close()
+
+	for it.Valid() {
+		if !fn(it.Key(), it.Value()) {
+			break
+		}
+		it.Next()
+	}
+	return it.Err()
+}
+
+// getBatch fetches values for an already-known set of keys, used to hydrate
+// an iterator window with values after a keys-only scan. The returned slices
+// point into arena_c and stay valid until that arena is freed or reused.
+func (db *DataBase) getBatch(txn_c C.ukv_txn_t, collection_c *C.ukv_collection_t, keys []uint64, arena_c *C.ukv_arena_t) ([][]byte, error) {
+	error_c := C.ukv_error_t(nil)
+	keys_c := (*C.ukv_key_t)(unsafe.Pointer(&keys[0]))
+	task_count_c := C.size_t(len(keys))
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	pulled_values_lengths_c := (*C.ukv_val_len_t)(nil)
+	pulled_values_c := (C.ukv_val_ptr_t)(nil)
+
+	C.u_read(db.Backend.UKV_read,
+		db.raw, txn_c, task_count_c,
+		collection_c, 0,
+		keys_c, C.size_of_key,
+		options_c,
+		&pulled_values_lengths_c,
+		&pulled_values_c,
+		arena_c,
+		&error_c)
+
+	error_go := forwardError(db, error_c)
+	if error_go != nil {
+		return nil, error_go
+	}
+
+	values := make([][]byte, len(keys))
+	offset := C.ukv_val_len_t(0)
+	for i := range keys {
+		length := C.dereference_index(pulled_values_lengths_c, C.ukv_size_t(i))
+		if length == db.Backend.UKV_val_len_missing {
+			values[i] = nil
+			continue
+		}
+		values[i] = unsafe.Slice((*byte)(unsafe.Pointer(uintptr(unsafe.Pointer(pulled_values_c))+uintptr(offset))), int(length))
+		offset += length
+	}
+	return values, nil
+}
+
+// TxnOptions configures a transaction started with BeginTxn.
+type TxnOptions struct {
+	// Snapshot pins a consistent read view for the lifetime of the
+	// transaction, so that repeated reads of the same key never observe a
+	// concurrent writer's changes until Commit.
+	Snapshot bool
+	// ReadOnly rejects writes against the transaction, allowing the
+	// backend to skip conflict tracking for it.
+	ReadOnly bool
+}
+
+func (opts TxnOptions) options() C.ukv_options_t {
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	if opts.Snapshot {
+		options_c |= C.ukv_options_t(C.ukv_option_txn_snapshot_k)
+	}
+	if opts.ReadOnly {
+		options_c |= C.ukv_options_t(C.ukv_option_txn_read_only_k)
+	}
+	return options_c
+}
+
+// Txn is an ACID transaction over a DataBase's default collection. Reads and
+// writes made through it are isolated from other transactions until Commit,
+// which fails with ErrTxnConflict if a concurrent writer touched the same
+// keys first.
+type Txn struct {
+	db  *DataBase
+	raw C.ukv_txn_t
+}
+
+// BeginTxn opens a new transaction. The returned Txn must be closed with
+// either Commit or Rollback.
+func (db *DataBase) BeginTxn(opts TxnOptions) (*Txn, error) {
+	error_c := C.ukv_error_t(nil)
+	raw := C.ukv_txn_t(nil)
+
+	C.u_txn_begin(db.Backend.UKV_txn_begin, db.raw, 0, opts.options(), &raw, &error_c)
+	if error_go := forwardError(db, error_c); error_go != nil {
+		return nil, error_go
+	}
+
+	return &Txn{db: db, raw: raw}, nil
+}
+
+// Snapshot is a consistent, read-only view of a DataBase pinned at the
+// moment it was taken; Get calls against it never observe writes made after
+// Snapshot() returned.
+type Snapshot struct {
+	txn *Txn
+}
+
+// Snapshot pins a consistent read view for multi-key reads. Close it once
+// it is no longer needed.
+func (db *DataBase) Snapshot() (*Snapshot, error) {
+	txn, err := db.BeginTxn(TxnOptions{Snapshot: true, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{txn: txn}, nil
+}
+
+func (snapshot *Snapshot) Get(key uint64) ([]byte, error) {
+	return snapshot.txn.Get(key)
+}
+
+func (snapshot *Snapshot) Contains(key uint64) (bool, error) {
+	return snapshot.txn.Contains(key)
+}
+
+// Close releases the snapshot's pinned read view.
+func (snapshot *Snapshot) Close() error {
+	return snapshot.txn.Rollback()
+}
+
+func (txn *Txn) Set(key uint64, value []byte) error {
+	return txn.db.set(txn.raw, (*C.ukv_collection_t)(nil), key, value)
+}
+
+func (txn *Txn) SetBatch(keys []uint64, values [][]byte) error {
+	return txn.db.setBatch(txn.raw, (*C.ukv_collection_t)(nil), keys, values)
+}
+
+func (txn *Txn) Delete(key uint64) error {
+	return txn.db.delete(txn.raw, (*C.ukv_collection_t)(nil), key)
+}
+
+func (txn *Txn) Get(key uint64) ([]byte, error) {
+	return txn.db.get(txn.raw, (*C.ukv_collection_t)(nil), key)
+}
+
+func (txn *Txn) Contains(key uint64) (bool, error) {
+	return txn.db.contains(txn.raw, (*C.ukv_collection_t)(nil), key)
+}
+
+func (txn *Txn) NewIterator(opts IterOptions) *Iterator {
+	return newIterator(txn.db, txn.raw, (*C.ukv_collection_t)(nil), opts)
+}
+
+// Commit applies the transaction's writes atomically. On a write-write
+// conflict with another transaction it returns ErrTxnConflict and the
+// caller should retry with a fresh Txn. The underlying ukv_txn_t is freed
+// on every exit path, so Commit is safe to follow with a deferred Rollback.
+func (txn *Txn) Commit() error {
+	if txn.raw == nil {
+		return nil
+	}
+
+	error_c := C.ukv_error_t(nil)
+	C.u_txn_commit(txn.db.Backend.UKV_txn_commit, txn.db.raw, txn.raw, C.ukv_options_t(C.ukv_options_default_k), &error_c)
+
+	// Conflicts are reported as the dedicated sentinel constant, not a
+	// freshly allocated message, so they can be told apart from a generic
+	// failure by identity instead of sniffing the error text.
+	isConflict := error_c == C.ukv_error_transaction_conflict_k
+
+	C.u_txn_free(txn.db.Backend.UKV_txn_free, txn.db.raw, txn.raw)
+	txn.raw = nil
+
+	if isConflict {
+		return ErrTxnConflict
+	}
+	return forwardError(txn.db, error_c)
+}
+
+// Rollback discards the transaction's writes without applying them.
+func (txn *Txn) Rollback() error {
+	if txn.raw == nil {
+		return nil
+	}
+	C.u_txn_free(txn.db.Backend.UKV_txn_free, txn.db.raw, txn.raw)
+	txn.raw = nil
+	return nil
+}
+
+// MergeFn computes the new value of a key given its current value (nil if
+// absent) and the operands accumulated since it was last read, mirroring
+// RocksDB's associative merge operator. It lets callers build counters,
+// sets and CRDTs without a read-modify-write round trip.
+type MergeFn func(key uint64, existing []byte, operands [][]byte) ([]byte, error)
+
+// Options configures a DataBase at open time.
+type Options struct {
+	MergeOperator MergeFn
+}
+
+var (
+	mergeOperatorsMu sync.Mutex
+	mergeOperators   = map[unsafe.Pointer]MergeFn{}
+)
+
+// ReConnectWithOptions opens the DataBase like ReConnect, additionally
+// registering the Options' MergeOperator, if any.
+func (db *DataBase) ReConnectWithOptions(config string, opts Options) error {
+	if err := db.ReConnect(config); err != nil {
+		return err
+	}
+	if opts.MergeOperator != nil {
+		return db.SetMergeOperator(opts.MergeOperator)
+	}
+	return nil
+}
+
+// SetMergeOperator registers fn as the DataBase's associative merge
+// operator, invoked by the backend on Merge/MergeBatch during reads and
+// compaction.
+func (db *DataBase) SetMergeOperator(fn MergeFn) error {
+	mergeOperatorsMu.Lock()
+	mergeOperators[unsafe.Pointer(db.raw)] = fn
+	mergeOperatorsMu.Unlock()
+
+	error_c := C.ukv_error_t(nil)
+	C.u_merge_operator_set(db.Backend.UKV_merge_operator_set, db.raw, unsafe.Pointer(C.merge_callback_fn(C.ukv_go_merge_callback)), &error_c)
+	return forwardError(db, error_c)
+}
+
+// Merge applies operand to key's existing value through the registered
+// MergeOperator instead of overwriting it outright.
+func (db *DataBase) Merge(key uint64, operand []byte) error {
+	error_c := C.ukv_error_t(nil)
+	key_c := C.ukv_key_t(key)
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	val_ptr := C.ukv_val_ptr_t(nil)
+	if len(operand) > 0 {
+		val_ptr = C.ukv_val_ptr_t(unsafe.Pointer(&operand[0]))
+	}
+	value_ptr_c := C.ukv_val_ptr_t(unsafe.Pointer(&val_ptr))
+	value_length_c := C.ukv_val_len_t(len(operand))
+	value_offset_c := C.ukv_val_len_t(0)
+	arena_c := (C.ukv_arena_t)(nil)
+	defer freeArena(db, arena_c)
+
+	C.u_write(db.Backend.UKV_merge,
+		db.raw, nil, 1,
+		(*C.ukv_collection_t)(nil), 0,
+		&key_c, 0,
+		value_ptr_c, 0,
+		&value_offset_c, 0,
+		&value_length_c, 0,
+		options_c, &arena_c, &error_c)
+	return forwardError(db, error_c)
+}
+
+// MergeBatch applies operands to their respective keys through the
+// registered MergeOperator, over a single contiguous, C-owned buffer built
+// the same way WriteBatch.Flush is, instead of guessing per-operand offsets
+// from the layout Go happens to give a [][]byte.
+func (db *DataBase) MergeBatch(keys []uint64, operands [][]byte) error {
+	task_count_c := C.size_t(len(operands))
+
+	keys_c := make([]C.ukv_key_t, len(keys))
+	offsets_c := make([]C.ukv_val_len_t, len(operands))
+	lens_c := make([]C.ukv_val_len_t, len(operands))
+	var buf []byte
+	for i, operand := range operands {
+		keys_c[i] = C.ukv_key_t(keys[i])
+		offsets_c[i] = C.ukv_val_len_t(len(buf))
+		lens_c[i] = C.ukv_val_len_t(len(operand))
+		buf = append(buf, operand...)
+	}
+
+	buf_c := C.malloc(C.size_t(len(buf)))
+	if len(buf) > 0 {
+		copy(unsafe.Slice((*byte)(buf_c), len(buf)), buf)
+	}
+	defer C.free(buf_c)
+
+	error_c := C.ukv_error_t(nil)
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	arena_c := (C.ukv_arena_t)(nil)
+	defer freeArena(db, arena_c)
+
+	C.u_write(db.Backend.UKV_merge,
+		db.raw, nil, task_count_c,
+		(*C.ukv_collection_t)(nil), 0,
+		&keys_c[0], C.size_of_key,
+		C.ukv_val_ptr_t(buf_c), 0,
+		&offsets_c[0], C.size_of_len,
+		&lens_c[0], C.size_of_len,
+		options_c, &arena_c, &error_c)
+	return forwardError(db, error_c)
+}
+
+//export ukv_go_merge_callback
+func ukv_go_merge_callback(c_db C.ukv_t, c_key C.ukv_key_t,
+	c_existing_ptr C.ukv_val_ptr_t, c_existing_len C.ukv_val_len_t,
+	c_operand_ptrs *C.ukv_val_ptr_t, c_operand_lens *C.ukv_val_len_t, c_operands_count C.ukv_size_t,
+	c_result_ptr *C.ukv_val_ptr_t, c_result_len *C.ukv_val_len_t, c_error *C.ukv_error_t) {
+
+	mergeOperatorsMu.Lock()
+	fn, ok := mergeOperators[unsafe.Pointer(c_db)]
+	mergeOperatorsMu.Unlock()
+	if !ok {
+		*c_error = C.CString("ukv: no merge operator registered for this DataBase")
+		return
+	}
+
+	var existing []byte
+	if c_existing_ptr != nil {
+		existing = C.GoBytes(unsafe.Pointer(c_existing_ptr), C.int(c_existing_len))
+	}
+
+	count := int(c_operands_count)
+	operand_ptrs := unsafe.Slice(c_operand_ptrs, count)
+	operand_lens := unsafe.Slice(c_operand_lens, count)
+	operands := make([][]byte, count)
+	for i := 0; i < count; i++ {
+		operands[i] = C.GoBytes(unsafe.Pointer(operand_ptrs[i]), C.int(operand_lens[i]))
+	}
+
+	result, err := fn(uint64(c_key), existing, operands)
+	if err != nil {
+		*c_error = C.CString(err.Error())
+		return
+	}
+
+	result_c := C.CBytes(result)
+	*c_result_ptr = C.ukv_val_ptr_t(result_c)
+	*c_result_len = C.ukv_val_len_t(len(result))
+}
+
+// Arena is a reusable buffer the backend fills on every read. Passing the
+// same Arena into successive calls saves the malloc/free round trip that
+// DataBase.Get pays on every single call; it must be Close()d once no
+// longer needed.
+type Arena struct {
+	db  *DataBase
+	raw C.ukv_arena_t
+}
+
+// NewArena allocates an empty, reusable Arena for this DataBase.
+func (db *DataBase) NewArena() *Arena {
+	arena := &Arena{db: db}
+	runtime.SetFinalizer(arena, (*Arena).Close)
+	return arena
+}
+
+// Close releases the memory backing the arena.
+func (arena *Arena) Close() {
+	if arena.raw != nil {
+		freeArena(arena.db, arena.raw)
+		arena.raw = nil
+	}
+}
+
+// GetWithArena behaves like Get, but fills values into arena instead of a
+// fresh one, so repeated calls reuse the same backing allocation.
+func (db *DataBase) GetWithArena(arena *Arena, key uint64) ([]byte, error) {
+	error_c := C.ukv_error_t(nil)
+	key_c := C.ukv_key_t(key)
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	pulled_values_lengths_c := (*C.ukv_val_len_t)(nil)
+	pulled_values_c := (C.ukv_val_ptr_t)(nil)
+
+	C.u_read(db.Backend.UKV_read,
+		db.raw, nil, 1,
+		(*C.ukv_collection_t)(nil), 0,
+		&key_c, 0,
+		options_c,
+		&pulled_values_lengths_c,
+		&pulled_values_c,
+		&arena.raw,
+		&error_c)
+
+	error_go := forwardError(db, error_c)
+	if error_go != nil {
+		return nil, error_go
+	}
+
+	pulled_value_length_c := C.dereference_index(pulled_values_lengths_c, 0)
+	if pulled_value_length_c == db.Backend.UKV_val_len_missing {
+		return nil, nil
+	}
+	return C.GoBytes(unsafe.Pointer(pulled_values_c), C.int(pulled_value_length_c)), nil
+}
+
+// SetWithArena behaves like Set, but uses arena for the scratch allocation
+// the write path needs, so repeated calls reuse the same backing memory.
+func (db *DataBase) SetWithArena(arena *Arena, key uint64, value []byte) error {
+	error_c := C.ukv_error_t(nil)
+	key_c := C.ukv_key_t(key)
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	val_ptr := C.ukv_val_ptr_t(unsafe.Pointer(&value[0]))
+	value_ptr_c := C.ukv_val_ptr_t(unsafe.Pointer(&val_ptr))
+	value_length_c := C.ukv_val_len_t(len(value))
+	value_offset_c := C.ukv_val_len_t(0)
+
+	C.u_write(db.Backend.UKV_write,
+		db.raw, nil, 1,
+		(*C.ukv_collection_t)(nil), 0,
+		&key_c, 0,
+		value_ptr_c, 0,
+		&value_offset_c, 0,
+		&value_length_c, 0,
+		options_c, &arena.raw, &error_c)
+	return forwardError(db, error_c)
+}
+
+// WriteOptions tunes the durability/performance trade-off of a WriteBatch
+// flush, mirroring RocksDB's WriteOptions.
+type WriteOptions struct {
+	// Sync forces the write to be durable on disk before returning.
+	Sync bool
+	// DisableWAL skips the write-ahead log, trading durability for speed.
+	DisableWAL bool
+}
+
+func (opts WriteOptions) options() C.ukv_options_t {
+	options_c := C.ukv_options_t(C.ukv_options_default_k)
+	if opts.Sync {
+		options_c |= C.ukv_options_t(C.ukv_option_write_flush_k)
+	}
+	if opts.DisableWAL {
+		options_c |= C.ukv_options_t(C.ukv_option_write_disable_wal_k)
+	}
+	return options_c
+}
+
+// WriteBatch accumulates keys and values and flushes them with a single
+// ukv_write call over one contiguous, C-owned buffer: every value is
+// appended back-to-back into a malloc'd block addressed by per-task
+// offsets/lengths, so there is exactly one strided write regardless of
+// batch size and no Go-pointer-to-Go-pointer juggling on the hot path.
+type WriteBatch struct {
+	db   *DataBase
+	keys []uint64
+	lens []uint32
+	buf  []byte
+}
+
+// NewWriteBatch creates an empty WriteBatch for this DataBase.
+func (db *DataBase) NewWriteBatch() *WriteBatch {
+	return &WriteBatch{db: db}
+}
+
+// Set stages a key/value pair; nothing is written until Flush is called.
+func (batch *WriteBatch) Set(key uint64, value []byte) {
+	batch.keys = append(batch.keys, key)
+	batch.lens = append(batch.lens, uint32(len(value)))
+	batch.buf = append(batch.buf, value...)
+}
+
+// Reset clears the batch so it can be reused for the next round of writes.
+func (batch *WriteBatch) Reset() {
+	batch.keys = batch.keys[:0]
+	batch.lens = batch.lens[:0]
+	batch.buf = batch.buf[:0]
+}
+
+// Len reports the number of keys staged in the batch.
+func (batch *WriteBatch) Len() int {
+	return len(batch.keys)
+}
+
+// Flush issues a single ukv_write call for every staged key/value pair.
+func (batch *WriteBatch) Flush(opts WriteOptions) error {
+	if len(batch.keys) == 0 {
+		return nil
+	}
+	db := batch.db
+	task_count_c := C.size_t(len(batch.keys))
+
+	buf_c := C.malloc(C.size_t(len(batch.buf)))
+	if len(batch.buf) > 0 {
+		copy(unsafe.Slice((*byte)(buf_c), len(batch.buf)), batch.buf)
+	}
+	defer C.free(buf_c)
+
+	keys_c := make([]C.ukv_key_t, len(batch.keys))
+	offsets_c := make([]C.ukv_val_len_t, len(batch.keys))
+	lens_c := make([]C.ukv_val_len_t, len(batch.keys))
+	offset := uint32(0)
+	for i, key := range batch.keys {
+		keys_c[i] = C.ukv_key_t(key)
+		offsets_c[i] = C.ukv_val_len_t(offset)
+		lens_c[i] = C.ukv_val_len_t(batch.lens[i])
+		offset += batch.lens[i]
+	}
+
+	error_c := C.ukv_error_t(nil)
+	arena_c := (C.ukv_arena_t)(nil)
+	defer freeArena(db, arena_c)
+
+	C.u_write(db.Backend.UKV_write,
+		db.raw, nil, task_count_c,
+		(*C.ukv_collection_t)(nil), 0,
+		&keys_c[0], C.size_of_key,
+		C.ukv_val_ptr_t(buf_c), 0,
+		&offsets_c[0], C.size_of_len,
+		&lens_c[0], C.size_of_len,
+		opts.options(), &arena_c, &error_c)
+	return forwardError(db, error_c)
+}
+
+// CreateCheckpoint writes a consistent point-in-time checkpoint of the
+// database into dir, following RocksDB's Checkpoint model. Backends with
+// no native checkpoint support, such as the in-memory STL backend, return
+// ErrNotSupported.
+func (db *DataBase) CreateCheckpoint(dir string) error {
+	if db.Backend.UKV_checkpoint_create == nil {
+		return ErrNotSupported
+	}
+
+	error_c := C.ukv_error_t(nil)
+	dir_c := C.CString(dir)
+	defer C.free(unsafe.Pointer(dir_c))
+
+	C.u_checkpoint_create(db.Backend.UKV_checkpoint_create, db.raw, dir_c, &error_c)
+	return forwardError(db, error_c)
+}
+
+// BackupInfo describes a single backup managed by a BackupEngine, mirroring
+// the fields RocksDB's BackupEngine reports for each backup it retains.
+type BackupInfo struct {
+	ID        uint64
+	Timestamp uint64
+	SizeBytes uint64
+	NumFiles  uint64
+}
+
+// BackupEngine manages a directory of incremental backups for a DataBase,
+// following the RocksDB BackupEngine model. For backends with no native
+// backup engine, such as the in-memory STL backend, OpenBackupEngine returns
+// ErrNotSupported, so no BackupEngine is ever created for them.
+type BackupEngine struct {
+	db  *DataBase
+	raw C.ukv_backup_t
+}
+
+// OpenBackupEngine opens (and implicitly creates) the backup engine rooted at dir.
+func (db *DataBase) OpenBackupEngine(dir string) (*BackupEngine, error) {
+	if db.Backend.UKV_backup_engine_open == nil {
+		return nil, ErrNotSupported
+	}
+
+	error_c := C.ukv_error_t(nil)
+	dir_c := C.CString(dir)
+	defer C.free(unsafe.Pointer(dir_c))
+	backup_c := C.ukv_backup_t(nil)
+
+	C.u_backup_engine_open(db.Backend.UKV_backup_engine_open, dir_c, &backup_c, &error_c)
+	if error_go := forwardError(db, error_c); error_go != nil {
+		return nil, error_go
+	}
+	return &BackupEngine{db: db, raw: backup_c}, nil
+}
+
+// Close releases the backup engine, without touching any backup it made.
+func (engine *BackupEngine) Close() {
+	if engine.raw != nil {
+		C.u_backup_free(engine.db.Backend.UKV_backup_free, engine.raw)
+		engine.raw = nil
+	}
+}
+
+// CreateBackup snapshots db into a new backup, optionally flushing any
+// buffered writes first so the backup observes them.
+func (engine *BackupEngine) CreateBackup(db *DataBase, flushBeforeBackup bool) error {
+	error_c := C.ukv_error_t(nil)
+	C.u_backup_create(engine.db.Backend.UKV_backup_create, engine.raw, db.raw, C.bool(flushBeforeBackup), &error_c)
+	return forwardError(engine.db, error_c)
+}
+
+// RestoreFromLatestBackup restores the most recent backup into targetDir,
+// which must be writable and is created if it doesn't already exist.
+func (engine *BackupEngine) RestoreFromLatestBackup(targetDir string) error {
+	error_c := C.ukv_error_t(nil)
+	dir_c := C.CString(targetDir)
+	defer C.free(unsafe.Pointer(dir_c))
+
+	C.u_backup_restore(engine.db.Backend.UKV_backup_restore, engine.raw, dir_c, &error_c)
+	return forwardError(engine.db, error_c)
+}
+
+// PurgeOldBackups discards every backup except the keep most recent ones.
+func (engine *BackupEngine) PurgeOldBackups(keep int) error {
+	error_c := C.ukv_error_t(nil)
+	C.u_backup_purge_old(engine.db.Backend.UKV_backup_purge_old, engine.raw, C.ukv_size_t(keep), &error_c)
+	return forwardError(engine.db, error_c)
+}
+
+// GetBackupInfo reports metadata about every backup currently retained.
+func (engine *BackupEngine) GetBackupInfo() ([]BackupInfo, error) {
+	error_c := C.ukv_error_t(nil)
+	count_c := C.ukv_size_t(0)
+	ids_c := (*C.ukv_size_t)(nil)
+	timestamps_c := (*C.ukv_size_t)(nil)
+	sizes_c := (*C.ukv_size_t)(nil)
+	num_files_c := (*C.ukv_size_t)(nil)
+
+	C.u_backup_get_info(engine.db.Backend.UKV_backup_get_info, engine.raw, &count_c,
+		&ids_c, &timestamps_c, &sizes_c, &num_files_c, &error_c)
+	if error_go := forwardError(engine.db, error_c); error_go != nil {
+		return nil, error_go
+	}
+
+	infos := make([]BackupInfo, count_c)
+	for i := C.ukv_size_t(0); i < count_c; i++ {
+		infos[i] = BackupInfo{
+			ID:        uint64(C.dereference_size_index(ids_c, i)),
+			Timestamp: uint64(C.dereference_size_index(timestamps_c, i)),
+			SizeBytes: uint64(C.dereference_size_index(sizes_c, i)),
+			NumFiles:  uint64(C.dereference_size_index(num_files_c, i)),
+		}
+	}
+	return infos, nil
+}
@@ -18,13 +18,28 @@ type Level struct {
 
 func CreateDB() Level {
 	backend := u.BackendInterface{
-		UKV_error_free:      C.ukv_error_free,
-		UKV_arena_free:      C.ukv_arena_free,
-		UKV_open:            C.ukv_db_open,
-		UKV_free:            C.ukv_db_free,
-		UKV_read:            C.ukv_read,
-		UKV_write:           C.ukv_write,
-		UKV_val_len_missing: u.UKV_val_len_t(C.ukv_val_len_missing_k)}
+		UKV_error_free:          C.ukv_error_free,
+		UKV_arena_free:          C.ukv_arena_free,
+		UKV_open:                C.ukv_db_open,
+		UKV_free:                C.ukv_db_free,
+		UKV_read:                C.ukv_read,
+		UKV_write:               C.ukv_write,
+		UKV_scan:                C.ukv_scan,
+		UKV_collection_open:     C.ukv_collection_open,
+		UKV_collection_drop:     C.ukv_collection_drop,
+		UKV_txn_begin:           C.ukv_txn_begin,
+		UKV_txn_commit:          C.ukv_txn_commit,
+		UKV_txn_free:            C.ukv_txn_free,
+		UKV_merge:               C.ukv_merge,
+		UKV_merge_operator_set:  C.ukv_merge_operator_set,
+		UKV_checkpoint_create:   C.ukv_checkpoint_create,
+		UKV_backup_engine_open:  C.ukv_backup_engine_open,
+		UKV_backup_create:       C.ukv_backup_create,
+		UKV_backup_restore:      C.ukv_backup_restore,
+		UKV_backup_purge_old:    C.ukv_backup_purge_old,
+		UKV_backup_get_info:     C.ukv_backup_get_info,
+		UKV_backup_free:         C.ukv_backup_free,
+		UKV_val_len_missing:     u.UKV_val_len_t(C.ukv_val_len_missing_k)}
 
 	db := Level{DataBase: u.DataBase{Backend: backend}}
 	return db
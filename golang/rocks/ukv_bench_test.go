@@ -0,0 +1,67 @@
+package ukv_test
+
+import (
+	"fmt"
+	"testing"
+
+	u "github.com/unum-cloud/UKV/golang/internal"
+	ukv "github.com/unum-cloud/UKV/golang/rocks"
+)
+
+func benchmarkSetBatch(b *testing.B, batchSize int) {
+	db := ukv.CreateDB()
+	if err := db.ReConnect(""); err != nil {
+		b.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	keys := make([]uint64, batchSize)
+	values := make([][]byte, batchSize)
+	for i := 0; i < batchSize; i++ {
+		keys[i] = uint64(i)
+		values[i] = []byte("some-benchmark-value")
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if err := db.SetBatch(keys, values); err != nil {
+			b.Fatalf("Couldn't set batch: %s", err)
+		}
+	}
+}
+
+func benchmarkWriteBatch(b *testing.B, batchSize int) {
+	db := ukv.CreateDB()
+	if err := db.ReConnect(""); err != nil {
+		b.Fatalf("Couldn't open db: %s", err)
+	}
+	defer db.Close()
+
+	batch := db.NewWriteBatch()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		batch.Reset()
+		for i := 0; i < batchSize; i++ {
+			batch.Set(uint64(i), []byte("some-benchmark-value"))
+		}
+		if err := batch.Flush(u.WriteOptions{}); err != nil {
+			b.Fatalf("Couldn't flush batch: %s", err)
+		}
+	}
+}
+
+func BenchmarkSetBatch(b *testing.B) {
+	for _, size := range []int{1, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			benchmarkSetBatch(b, size)
+		})
+	}
+}
+
+func BenchmarkWriteBatch(b *testing.B) {
+	for _, size := range []int{1, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			benchmarkWriteBatch(b, size)
+		})
+	}
+}
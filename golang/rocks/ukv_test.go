@@ -3,63 +3,66 @@ package ukv_test
 import (
 	"testing"
 
+	utest "github.com/unum-cloud/UKV/golang/internal/testing"
 	ukv "github.com/unum-cloud/UKV/golang/rocks"
 )
 
 func TestDataBaseSimple(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseSimpleTest(&db, t)
+}
 
+func TestDataBaseRangeScan(t *testing.T) {
 	db := ukv.CreateDB()
-	if err := db.ReConnect(""); err != nil {
-		t.Fatalf("Couldn't open db: %s", err)
-	}
+	utest.DataBaseRangeScanTest(&db, t)
+}
 
-	defer db.Close()
-	if err := db.Set(42, []byte{1, 1, 1}); err != nil {
-		t.Fatalf("Couldn't set value: %s", err)
-	}
+func TestDataBaseCollection(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseCollectionTest(&db, t)
+}
 
-	if _, err := db.Get(42); err != nil {
-		t.Fatalf("Couldn't get value: %s", err)
-	}
+func TestDataBaseTxnSnapshotIsolation(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseTxnSnapshotIsolationTest(&db, t)
+}
 
-	if _, err := db.Contains(42); err != nil {
-		t.Fatalf("Couldn't check value existance: %s", err)
-	}
+func TestDataBaseTxnConflict(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseTxnConflictTest(&db, t)
 }
 
-func TestDataBaseBatchInsert(t *testing.T) {
+func TestDataBaseMerge(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseMergeTest(&db, t)
+}
 
+func TestDataBaseMergeAtOpen(t *testing.T) {
 	db := ukv.CreateDB()
-	if err := db.ReConnect(""); err != nil {
-		t.Fatalf("Couldn't open db: %s", err)
-	}
+	utest.DataBaseMergeAtOpenTest(&db, t)
+}
 
-	defer db.Close()
-	keys := []uint64{4, 6, 8}
-	values := [][]byte{
-		[]byte("Hello"),
-		[]byte("This"),
-		[]byte("Day")}
+func TestDataBaseWriteBatch(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseWriteBatchTest(&db, t)
+}
 
-	if err := db.SetBatch(keys, values); err != nil {
-		t.Fatalf("Couldn't set value: %s", err)
-	}
+func TestDataBaseArena(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseArenaTest(&db, t)
+}
 
-	for i := 0; i < len(keys); i++ {
-		val, err := db.Get(keys[i])
-		if err != nil {
-			t.Fatalf("Couldn't get value: %s", err)
-		}
-		if string(val) != string(values[i]) {
-			t.Fatalf("Wrong Value: Expected: %s, Got: %s", string(values[i]), string(val))
-		}
-	}
+func TestDataBaseBackupRestore(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseBackupRestoreTest(&db, t)
+}
 
-	if _, err := db.Get(42); err != nil {
-		t.Fatalf("Couldn't get value: %s", err)
-	}
+func TestDataBaseCheckpoint(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseCheckpointTest(&db, t)
+}
 
-	if _, err := db.Contains(42); err != nil {
-		t.Fatalf("Couldn't check value existance: %s", err)
-	}
+func TestDataBaseBatchInsert(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseBatchInsertTest(&db, t)
 }
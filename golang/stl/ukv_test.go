@@ -16,3 +16,48 @@ func TestDataBaseBatchInsert(t *testing.T) {
 	db := ukv.CreateDB()
 	utest.DataBaseBatchInsertTest(&db, t)
 }
+
+func TestDataBaseRangeScan(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseRangeScanTest(&db, t)
+}
+
+func TestDataBaseCollection(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseCollectionTest(&db, t)
+}
+
+func TestDataBaseTxnSnapshotIsolation(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseTxnSnapshotIsolationTest(&db, t)
+}
+
+func TestDataBaseTxnConflict(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseTxnConflictTest(&db, t)
+}
+
+func TestDataBaseMerge(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseMergeTest(&db, t)
+}
+
+func TestDataBaseMergeAtOpen(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseMergeAtOpenTest(&db, t)
+}
+
+func TestDataBaseWriteBatch(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseWriteBatchTest(&db, t)
+}
+
+func TestDataBaseArena(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseArenaTest(&db, t)
+}
+
+func TestDataBaseBackupNotSupported(t *testing.T) {
+	db := ukv.CreateDB()
+	utest.DataBaseBackupNotSupportedTest(&db, t)
+}
@@ -0,0 +1,39 @@
+package ukv
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/../../lib -lukv_stl -lstdc++
+#cgo CFLAGS: -g -Wall -I${SRCDIR}/../../include
+
+#include "ukv/db.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	u "github.com/unum-cloud/UKV/golang/internal"
+)
+
+type Umem struct {
+	u.DataBase
+}
+
+func CreateDB() Umem {
+	backend := u.BackendInterface{
+		UKV_error_free:          C.ukv_error_free,
+		UKV_arena_free:          C.ukv_arena_free,
+		UKV_open:                C.ukv_db_open,
+		UKV_free:                C.ukv_db_free,
+		UKV_read:                C.ukv_read,
+		UKV_write:               C.ukv_write,
+		UKV_scan:                C.ukv_scan,
+		UKV_collection_open:     C.ukv_collection_open,
+		UKV_collection_drop:     C.ukv_collection_drop,
+		UKV_txn_begin:           C.ukv_txn_begin,
+		UKV_txn_commit:          C.ukv_txn_commit,
+		UKV_txn_free:            C.ukv_txn_free,
+		UKV_merge:               C.ukv_merge,
+		UKV_merge_operator_set:  C.ukv_merge_operator_set,
+		UKV_val_len_missing:     u.UKV_val_len_t(C.ukv_val_len_missing_k)}
+
+	db := Umem{DataBase: u.DataBase{Backend: backend}}
+	return db
+}